@@ -3,16 +3,21 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
+	"math"
 	"math/rand"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
+	"unicode"
 )
 
 // ANSI color codes for better terminal output (auto-disable if NO_COLOR or not TTY-like)
@@ -57,6 +62,13 @@ func colorsEnabled() bool {
 	return (fi.Mode() & os.ModeCharDevice) != 0
 }
 
+// Hint is one tier of a word's hint ladder: revealing Text costs Cost
+// points off whatever the question would otherwise earn.
+type Hint struct {
+	Text string `json:"text"`
+	Cost int    `json:"cost"`
+}
+
 type Word struct {
 	Word       string `json:"word"`
 	Article    string `json:"article"`
@@ -64,49 +76,362 @@ type Word struct {
 	Category   string `json:"category,omitempty"`
 	Difficulty string `json:"difficulty,omitempty"`
 	Plural     string `json:"plural,omitempty"`
+	Hints      []Hint `json:"hints,omitempty"`
+	// Accept lists additional plural spellings the plural quiz should
+	// take as correct alongside Plural (e.g. old orthography, regional
+	// variants), checked case-insensitively.
+	Accept []string `json:"accept,omitempty"`
+	// PluralHints is the plural quiz's own hint ladder; when empty,
+	// pluralHintsForWord derives one from hintsForWord's ladder instead.
+	PluralHints []string `json:"plural_hints,omitempty"`
+	// PluralRegex, when set, is an additional case-insensitive pattern
+	// the plural quiz accepts (e.g. to cover a productive variant Accept
+	// doesn't enumerate).
+	PluralRegex string `json:"plural_regex,omitempty"`
+}
+
+// hintsForWord returns w.Hints when the word file authored explicit
+// tiers, otherwise builds a default cost-increasing ladder out of
+// Category, Plural, and English.
+func hintsForWord(w Word) []Hint {
+	if len(w.Hints) > 0 {
+		return w.Hints
+	}
+	var hints []Hint
+	if w.Category != "" {
+		hints = append(hints, Hint{Text: "Category: " + w.Category, Cost: 1})
+	}
+	if w.Plural != "" {
+		hints = append(hints, Hint{Text: "Plural: " + w.Plural, Cost: 1})
+	}
+	if w.English != "" {
+		hints = append(hints, Hint{Text: "EN: " + w.English, Cost: 2})
+	}
+	return hints
+}
+
+// meaningHintsForWord returns the hint ladder for the meaning-quiz mode:
+// the same ladder as hintsForWord, but with English itself dropped (the
+// meaning quiz's correct choice text *is* English, so handing it out as
+// a hint would reveal the answer) and replaced by a partial reveal.
+func meaningHintsForWord(w Word) []Hint {
+	var hints []Hint
+	for _, h := range hintsForWord(w) {
+		if w.English != "" && h.Text == "EN: "+w.English {
+			continue
+		}
+		hints = append(hints, h)
+	}
+	if w.English != "" {
+		runes := []rune(w.English)
+		hints = append(hints, Hint{Text: fmt.Sprintf("Starts with '%c', %d letters", runes[0], len(runes)), Cost: 2})
+	}
+	return hints
+}
+
+// pluralHintsForWord returns a cost-increasing ladder built from
+// w.PluralHints when the word file authored explicit ones, otherwise
+// falls back to the word's ordinary hint ladder with the literal
+// Plural hint dropped (it's the plural quiz's correct answer).
+func pluralHintsForWord(w Word) []Hint {
+	if len(w.PluralHints) > 0 {
+		hints := make([]Hint, len(w.PluralHints))
+		for i, text := range w.PluralHints {
+			hints[i] = Hint{Text: text, Cost: i + 1}
+		}
+		return hints
+	}
+
+	// Falling all the way back to hintsForWord would include its
+	// "Plural: X" tier verbatim — the plural quiz's correct answer — so
+	// that tier is dropped and replaced with a partial reveal instead.
+	var hints []Hint
+	for _, h := range hintsForWord(w) {
+		if w.Plural != "" && h.Text == "Plural: "+w.Plural {
+			continue
+		}
+		hints = append(hints, h)
+	}
+	if w.Plural != "" {
+		runes := []rune(w.Plural)
+		hints = append(hints, Hint{Text: fmt.Sprintf("Starts with '%c', %d letters", runes[0], len(runes)), Cost: 2})
+	}
+	return hints
+}
+
+// matchPlural reports whether answer is an acceptable plural for word:
+// an exact case-insensitive match against Plural or any entry in
+// Accept, or a match against PluralRegex.
+func matchPlural(word Word, answer string) bool {
+	answer = strings.TrimSpace(strings.ToLower(answer))
+	if answer == "" {
+		return false
+	}
+	if word.Plural != "" && answer == strings.ToLower(word.Plural) {
+		return true
+	}
+	for _, alt := range word.Accept {
+		if answer == strings.ToLower(alt) {
+			return true
+		}
+	}
+	if word.PluralRegex != "" {
+		if re, err := regexp.Compile("(?i)" + word.PluralRegex); err == nil {
+			return re.MatchString(answer)
+		}
+	}
+	return false
+}
+
+// pluralDisplay is what the plural quiz shows as "the correct answer"
+// when the learner gets a question wrong.
+func pluralDisplay(word Word) string {
+	if word.Plural != "" {
+		return word.Plural
+	}
+	return "(matches pattern: " + word.PluralRegex + ")"
+}
+
+// MCQ is a single meaning-quiz question: pick the correct English
+// meaning for Prompt (the German noun with its article) out of Choices.
+type MCQ struct {
+	Prompt     string
+	Choices    []string
+	CorrectIdx int
+}
+
+// buildMCQ builds an n-choice MCQ for word, drawing its distractors from
+// pool: words in the same Category are preferred, falling back to any
+// other word with an English meaning once the category runs dry.
+func buildMCQ(word Word, pool []Word, n int) MCQ {
+	if n < 2 {
+		n = 2
+	}
+
+	var sameCategory, others []string
+	seen := map[string]bool{strings.ToLower(word.English): true}
+	for _, w := range pool {
+		if w.Word == word.Word || w.English == "" {
+			continue
+		}
+		key := strings.ToLower(w.English)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if word.Category != "" && strings.EqualFold(w.Category, word.Category) {
+			sameCategory = append(sameCategory, w.English)
+		} else {
+			others = append(others, w.English)
+		}
+	}
+	rand.Shuffle(len(sameCategory), func(i, j int) { sameCategory[i], sameCategory[j] = sameCategory[j], sameCategory[i] })
+	rand.Shuffle(len(others), func(i, j int) { others[i], others[j] = others[j], others[i] })
+
+	distractors := append(sameCategory, others...)
+	if len(distractors) > n-1 {
+		distractors = distractors[:n-1]
+	}
+
+	choices := append([]string{word.English}, distractors...)
+	rand.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+
+	correctIdx := 0
+	for i, c := range choices {
+		if c == word.English {
+			correctIdx = i
+			break
+		}
+	}
+
+	return MCQ{
+		Prompt:     fmt.Sprintf("%s %s", word.Article, word.Word),
+		Choices:    choices,
+		CorrectIdx: correctIdx,
+	}
 }
 
-type Words struct {
-	Version string `json:"version"`
-	Data    []Word `json:"data"`
+// defaultScoreScheme maps a word's difficulty to the points a correct
+// answer is worth. Words without an explicit difficulty are treated as
+// "medium". Override via the --score-scheme flag.
+var defaultScoreScheme = map[string]int{
+	"baby":    1,
+	"easy":    2,
+	"medium":  3,
+	"hard":    4,
+	"extreme": 5,
+}
+
+// difficultyOrder is the tier progression used for difficulty unlocking:
+// a tier (other than the first) stays locked until the learner has
+// mastered enough words in the tier just before it, or enough words
+// across all easier tiers combined (see tierUnlocked).
+var difficultyOrder = []string{"baby", "easy", "medium", "hard", "extreme"}
+
+// tierIndex returns tier's position in difficultyOrder, or -1 for a tier
+// not on the standard ladder (treated as always unlocked).
+func tierIndex(tier string) int {
+	tier = strings.ToLower(tier)
+	for i, t := range difficultyOrder {
+		if t == tier {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseScoreScheme parses a "level=points,level=points" string (the
+// format accepted by --score-scheme) into a score table, starting from
+// defaultScoreScheme so unspecified levels keep their default value.
+func parseScoreScheme(s string) (map[string]int, error) {
+	scheme := make(map[string]int, len(defaultScoreScheme))
+	for level, points := range defaultScoreScheme {
+		scheme[level] = points
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return scheme, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid score-scheme entry %q (want level=points)", pair)
+		}
+		level := strings.ToLower(strings.TrimSpace(parts[0]))
+		points, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid point value for %q: %w", level, err)
+		}
+		scheme[level] = points
+	}
+	return scheme, nil
+}
+
+// wordDifficulty returns w.Difficulty, defaulting to "medium" when unset.
+func wordDifficulty(w Word) string {
+	if w.Difficulty == "" {
+		return "medium"
+	}
+	return strings.ToLower(w.Difficulty)
+}
+
+// WordStat tracks lifetime mistakes for a word, broken down by which
+// game mode the mistake happened in.
+type WordStat struct {
+	ArticleMisses int `json:"article_misses"`
+	MeaningMisses int `json:"meaning_misses"`
+	PluralMisses  int `json:"plural_misses"`
+}
+
+// currentStatsVersion is bumped whenever stats.json gains a field that
+// needs load-time migration from older saves.
+const currentStatsVersion = 2
+
+// MasteryRecord tracks a word's lifetime practice history for the
+// difficulty-unlocking gate: it counts as "mastered" once Streak >= 3
+// and Correct/Seen >= 0.8.
+type MasteryRecord struct {
+	Seen     int       `json:"seen"`
+	Correct  int       `json:"correct"`
+	Streak   int       `json:"streak"`
+	LastSeen time.Time `json:"last_seen"`
 }
 
 type Stats struct {
-	TotalQuizzes   int            `json:"total_quizzes"`
-	TotalQuestions int            `json:"total_questions"`
-	CorrectAnswers int            `json:"correct_answers"`
-	WordStats      map[string]int `json:"word_stats"` // lifetime mistakes per word
+	Version            int                      `json:"version"`
+	TotalQuizzes       int                      `json:"total_quizzes"`
+	TotalQuestions     int                      `json:"total_questions"`
+	CorrectAnswers     int                      `json:"correct_answers"`
+	TotalPoints        int                      `json:"total_points"`
+	PointsByDifficulty map[string]int           `json:"points_by_difficulty"`
+	WordStats          map[string]WordStat      `json:"word_stats"`
+	HintsUsed          int                      `json:"hints_used"`
+	QuestionsNoHints   int                      `json:"questions_no_hints"`
+	CorrectNoHints     int                      `json:"correct_no_hints"`
+	Mastery            map[string]MasteryRecord `json:"mastery"`
+	BestAccuracy       float64                  `json:"best_accuracy"`
+	CurrentStreak      int                      `json:"current_streak"`
+	LongestStreak      int                      `json:"longest_streak"`
 }
 
 type MistakeInfo struct {
 	word          Word
 	userAnswer    string
 	correctAnswer string
+	elapsed       time.Duration
 }
 
 type Quiz struct {
-	words  []Word
-	stats  *Stats
-	reader *bufio.Reader
-	rng    *rand.Rand
+	words       []Word
+	stats       *Stats
+	profileName string
+	reader      *bufio.Reader
+	// lines is fed by the single long-lived goroutine (pumpInput) that
+	// owns reader; every call that needs a line of input selects on
+	// this channel instead of reading reader directly, so there is
+	// never more than one goroutine blocked in ReadString at a time.
+	lines chan inputLine
+	// pendingStaleLine marks that the last question timed out before the
+	// player's answer reached us; that answer is still in flight on
+	// lines and belongs to the question that already gave up on it, so
+	// the next read discards it instead of handing it to whatever asks
+	// for input next.
+	pendingStaleLine bool
+	rng              *rand.Rand
+	scoreScheme      map[string]int
+	// masteryUnlockCount is the alternate unlock path for a tier: once
+	// this many words are mastered across all easier tiers combined,
+	// the tier opens regardless of per-tier mastery percentage.
+	masteryUnlockCount int
 	sessionStats struct {
-		correct   int
-		total     int
-		mistakes  []MistakeInfo
-		startTime time.Time
+		correct            int
+		total              int
+		totalPoints        int
+		pointsByDifficulty map[string]int
+		mistakes           []MistakeInfo
+		answerTimes        []time.Duration
+		hintsUsed          int
+		questionsNoHints   int
+		correctNoHints     int
+		startTime          time.Time
 	}
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(runValidate(os.Args[2:]))
+	}
+
+	scoreSchemeFlag := flag.String("score-scheme", "", "override difficulty point values, e.g. \"baby=1,easy=2,medium=3,hard=4,extreme=5\"")
+	masteryUnlockFlag := flag.Int("mastery-unlock", 15, "alternate tier-unlock threshold: total mastered words across all easier tiers")
+	profileFlag := flag.String("profile", "", "profile name to load/save stats under (skips the startup profile prompt)")
+	verboseFlag := flag.Bool("verbose", false, "print details for any word-file rows that failed to load")
+	flag.Parse()
+
 	quiz := NewQuiz()
+	quiz.masteryUnlockCount = *masteryUnlockFlag
+
+	scheme, err := parseScoreScheme(*scoreSchemeFlag)
+	if err != nil {
+		fmt.Printf("%sInvalid --score-scheme: %v%s\n", ColorRed, err, ColorReset)
+		return
+	}
+	quiz.scoreScheme = scheme
 
 	quiz.setupSignalHandler()
 
-	if err := quiz.LoadWords("words.json"); err != nil {
+	report, err := quiz.LoadWords("words.json")
+	if err != nil {
 		fmt.Printf("%sError loading words: %v%s\n", ColorRed, err, ColorReset)
 		return
 	}
+	printLoadReport(report, *verboseFlag)
 
+	quiz.profileName = chooseProfile(*profileFlag, quiz)
 	quiz.LoadStats()
 	defer quiz.SaveStats()
 
@@ -114,12 +439,103 @@ func main() {
 	quiz.RunGameLoop()
 }
 
+// printLoadReport prints the colored startup summary for a word-file
+// load, and the individual per-row issues when verbose is set.
+func printLoadReport(report LoadReport, verbose bool) {
+	if len(report.Skipped) == 0 {
+		fmt.Printf("%sLoaded %d words.%s\n", ColorGreen, report.Loaded, ColorReset)
+		return
+	}
+	if verbose {
+		fmt.Printf("%sLoaded %d words, skipped %d:%s\n", ColorYellow, report.Loaded, len(report.Skipped), ColorReset)
+		for _, issue := range report.Skipped {
+			label := issue.Word
+			if label == "" {
+				label = "(unparseable)"
+			}
+			fmt.Printf("  • row %d (%s): %v\n", issue.Index, label, issue.Err)
+		}
+		return
+	}
+	fmt.Printf("%sLoaded %d words, skipped %d — see --verbose%s\n",
+		ColorYellow, report.Loaded, len(report.Skipped), ColorReset)
+}
+
+// runValidate implements the "diederdas validate <file>" subcommand: it
+// loads and validates a word file without starting the quiz, printing
+// every issue found and returning a non-zero status if any exist.
+func runValidate(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: diederdas validate <words.json>")
+		return 2
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		fmt.Printf("%sError: %v%s\n", ColorRed, err, ColorReset)
+		return 1
+	}
+	defer file.Close()
+
+	q := &Quiz{}
+	report, err := q.loadWordsFrom(file, args[0])
+	if err != nil {
+		fmt.Printf("%sError: %v%s\n", ColorRed, err, ColorReset)
+		if len(report.Skipped) > 0 {
+			printLoadReport(report, true)
+		}
+		return 1
+	}
+
+	printLoadReport(report, true)
+	if len(report.Skipped) > 0 {
+		return 1
+	}
+	return 0
+}
+
 func NewQuiz() *Quiz {
-	return &Quiz{
-		reader: bufio.NewReader(os.Stdin),
-		stats:  &Stats{WordStats: make(map[string]int)},
-		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	q := &Quiz{
+		stats:              &Stats{WordStats: make(map[string]WordStat), PointsByDifficulty: make(map[string]int), Mastery: make(map[string]MasteryRecord)},
+		profileName:        "default",
+		reader:             bufio.NewReader(os.Stdin),
+		lines:              make(chan inputLine),
+		rng:                rand.New(rand.NewSource(time.Now().UnixNano())),
+		scoreScheme:        defaultScoreScheme,
+		masteryUnlockCount: 15,
 	}
+	go q.pumpInput()
+	return q
+}
+
+// inputLine is one line read off stdin by pumpInput, along with any
+// error ReadString returned alongside it (typically io.EOF).
+type inputLine struct {
+	text string
+	err  error
+}
+
+// pumpInput is the only goroutine that ever calls q.reader.ReadString.
+// It runs for the lifetime of the quiz, forwarding each line it reads
+// onto q.lines; getInput and readAnswerWithTimeout both receive from
+// that channel instead of racing each other on the shared reader.
+func (q *Quiz) pumpInput() {
+	for {
+		text, err := q.reader.ReadString('\n')
+		q.lines <- inputLine{text: text, err: err}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// pointsForWord returns how many points a correct answer for w is worth,
+// according to the quiz's active score scheme.
+func (q *Quiz) pointsForWord(w Word) int {
+	if points, ok := q.scoreScheme[wordDifficulty(w)]; ok {
+		return points
+	}
+	return q.scoreScheme["medium"]
 }
 
 func (q *Quiz) setupSignalHandler() {
@@ -134,76 +550,388 @@ func (q *Quiz) setupSignalHandler() {
 	}()
 }
 
-func (q *Quiz) LoadWords(filename string) error {
+// LoadReport summarizes a word-file load: how many words loaded
+// cleanly, and which rows were skipped and why.
+type LoadReport struct {
+	Loaded  int
+	Skipped []WordIssue
+}
+
+// WordIssue records why a single row in the word file was skipped.
+type WordIssue struct {
+	Index int
+	Word  string
+	Err   error
+}
+
+// rawWords is the on-disk word-file shape, with each entry left as raw
+// JSON so LoadWords can validate and report issues row-by-row instead of
+// aborting the whole load on the first malformed entry.
+type rawWords struct {
+	Version string            `json:"version"`
+	Data    []json.RawMessage `json:"data"`
+}
+
+// validateWord rejects a decoded Word that the quiz can't use: no word
+// text, an article outside der/die/das, or an unparseable PluralRegex.
+func validateWord(w Word) error {
+	if w.Word == "" {
+		return fmt.Errorf("missing word")
+	}
+	switch w.Article {
+	case "der", "die", "das":
+	default:
+		return fmt.Errorf("invalid article %q (want der/die/das)", w.Article)
+	}
+	if w.PluralRegex != "" {
+		if _, err := regexp.Compile(w.PluralRegex); err != nil {
+			return fmt.Errorf("invalid plural_regex: %w", err)
+		}
+	}
+	return nil
+}
+
+func (q *Quiz) LoadWords(filename string) (LoadReport, error) {
 	file, err := os.Open(filename)
 	if err != nil {
-		// Try a couple of fallback locations
+		// Try a fallback location before giving up.
 		alt := filepath.Join(getDataDir(), filename)
-		if f2, err2 := os.Open(alt); err2 == nil {
-			defer f2.Close()
-			var words Words
-			if err := json.NewDecoder(f2).Decode(&words); err != nil {
-				return fmt.Errorf("could not decode JSON at %s: %w", alt, err)
-			}
-			if len(words.Data) == 0 {
-				return fmt.Errorf("no words found in %s", alt)
-			}
-			q.words = words.Data
-			return nil
+		f2, err2 := os.Open(alt)
+		if err2 != nil {
+			return LoadReport{}, fmt.Errorf("could not open %s: %w", filename, err)
 		}
-		return fmt.Errorf("could not open %s: %w", filename, err)
+		defer f2.Close()
+		return q.loadWordsFrom(f2, alt)
 	}
 	defer file.Close()
+	return q.loadWordsFrom(file, filename)
+}
 
-	var words Words
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&words); err != nil {
-		return fmt.Errorf("could not decode JSON: %w", err)
+// loadWordsFrom decodes the word list from r, validating each entry
+// individually so one malformed row doesn't abort the whole load.
+func (q *Quiz) loadWordsFrom(r io.Reader, source string) (LoadReport, error) {
+	var raw rawWords
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return LoadReport{}, fmt.Errorf("could not decode JSON at %s: %w", source, err)
 	}
 
-	if len(words.Data) == 0 {
-		return fmt.Errorf("no words found in file")
+	var report LoadReport
+	words := make([]Word, 0, len(raw.Data))
+	for i, entry := range raw.Data {
+		var w Word
+		if err := json.Unmarshal(entry, &w); err != nil {
+			report.Skipped = append(report.Skipped, WordIssue{Index: i, Err: err})
+			continue
+		}
+		if err := validateWord(w); err != nil {
+			report.Skipped = append(report.Skipped, WordIssue{Index: i, Word: w.Word, Err: err})
+			continue
+		}
+		words = append(words, w)
 	}
 
-	q.words = words.Data
-	return nil
+	if len(words) == 0 {
+		return report, fmt.Errorf("no usable words found in %s", source)
+	}
+
+	q.words = words
+	report.Loaded = len(words)
+	return report, nil
+}
+
+// statsEnvelope mirrors Stats but leaves word_stats as raw JSON so
+// LoadStats can tell the current {article_misses, meaning_misses} shape
+// apart from the legacy flat "word -> mistake count" shape before
+// picking how to decode it.
+type statsEnvelope struct {
+	Version            int                      `json:"version"`
+	TotalQuizzes       int                      `json:"total_quizzes"`
+	TotalQuestions     int                      `json:"total_questions"`
+	CorrectAnswers     int                      `json:"correct_answers"`
+	TotalPoints        int                      `json:"total_points"`
+	PointsByDifficulty map[string]int           `json:"points_by_difficulty"`
+	WordStats          json.RawMessage          `json:"word_stats"`
+	HintsUsed          int                      `json:"hints_used"`
+	QuestionsNoHints   int                      `json:"questions_no_hints"`
+	CorrectNoHints     int                      `json:"correct_no_hints"`
+	Mastery            map[string]MasteryRecord `json:"mastery"`
+	BestAccuracy       float64                  `json:"best_accuracy"`
+	CurrentStreak      int                      `json:"current_streak"`
+	LongestStreak      int                      `json:"longest_streak"`
 }
 
 func (q *Quiz) LoadStats() {
-	statsFile := filepath.Join(getDataDir(), "stats.json")
-	file, err := os.Open(statsFile)
-	if err != nil {
+	stats, err := loadProfileStats(q.profilePath())
+	if err == nil {
+		q.stats = stats
 		q.ensureStatsDefaults()
 		return
 	}
-	defer file.Close()
 
-	dec := json.NewDecoder(file)
-	if err := dec.Decode(q.stats); err != nil {
-		fmt.Printf("%sWarning: could not parse stats.json, starting fresh (%v)%s\n", ColorYellow, err, ColorReset)
+	if !os.IsNotExist(err) {
+		fmt.Printf("%sWarning: could not parse stats for profile '%s', starting fresh (%v)%s\n",
+			ColorYellow, q.profileName, err, ColorReset)
 		q.stats = &Stats{}
+		q.ensureStatsDefaults()
+		return
+	}
+
+	// No stats file for this profile yet. Before starting fresh, check
+	// for the pre-profile build's single stats.json so an upgrading
+	// user's lifetime progress doesn't get silently orphaned.
+	if legacy, legacyErr := loadProfileStats(legacyStatsPath()); legacyErr == nil {
+		fmt.Printf("%sFound pre-profile stats; migrating them into profile '%s'.%s\n",
+			ColorGreen, q.profileName, ColorReset)
+		q.stats = legacy
+		q.ensureStatsDefaults()
+		q.SaveStats()
+		if err := os.Rename(legacyStatsPath(), legacyStatsPath()+".bak"); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not archive legacy stats.json: %v\n", err)
+		}
+		return
 	}
+
+	q.stats = &Stats{}
 	q.ensureStatsDefaults()
 }
 
+// loadProfileStats decodes a profile's stats.json, migrating word_stats
+// from its legacy flat-map shape when necessary. The file's open error
+// (including os.IsNotExist) is returned unchanged so callers can tell a
+// missing profile from a corrupt one.
+func loadProfileStats(path string) (*Stats, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var env statsEnvelope
+	if err := json.NewDecoder(file).Decode(&env); err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		Version:            currentStatsVersion,
+		TotalQuizzes:       env.TotalQuizzes,
+		TotalQuestions:     env.TotalQuestions,
+		CorrectAnswers:     env.CorrectAnswers,
+		TotalPoints:        env.TotalPoints,
+		PointsByDifficulty: env.PointsByDifficulty,
+		WordStats:          migrateWordStats(env.WordStats),
+		HintsUsed:          env.HintsUsed,
+		QuestionsNoHints:   env.QuestionsNoHints,
+		CorrectNoHints:     env.CorrectNoHints,
+		Mastery:            env.Mastery,
+		BestAccuracy:       env.BestAccuracy,
+		CurrentStreak:      env.CurrentStreak,
+		LongestStreak:      env.LongestStreak,
+	}, nil
+}
+
+// migrateWordStats decodes word_stats in its current shape
+// (map[string]WordStat) and falls back to the legacy flat
+// map[string]int (plain lifetime mistake counts, treated as article
+// misses) when that fails.
+func migrateWordStats(raw json.RawMessage) map[string]WordStat {
+	if len(raw) == 0 {
+		return make(map[string]WordStat)
+	}
+
+	var current map[string]WordStat
+	if err := json.Unmarshal(raw, &current); err == nil {
+		return current
+	}
+
+	var legacy map[string]int
+	if err := json.Unmarshal(raw, &legacy); err == nil {
+		migrated := make(map[string]WordStat, len(legacy))
+		for word, mistakes := range legacy {
+			migrated[word] = WordStat{ArticleMisses: mistakes}
+		}
+		return migrated
+	}
+
+	return make(map[string]WordStat)
+}
+
 func (q *Quiz) ensureStatsDefaults() {
 	if q.stats == nil {
 		q.stats = &Stats{}
 	}
 	if q.stats.WordStats == nil {
-		q.stats.WordStats = make(map[string]int)
+		q.stats.WordStats = make(map[string]WordStat)
+	}
+	if q.stats.PointsByDifficulty == nil {
+		q.stats.PointsByDifficulty = make(map[string]int)
+	}
+	if q.stats.Mastery == nil {
+		q.stats.Mastery = make(map[string]MasteryRecord)
+	}
+	q.stats.Version = currentStatsVersion
+}
+
+// isMastered reports whether rec has crossed the mastery bar: a streak
+// of at least 3 correct answers in a row, with at least 80% lifetime
+// accuracy on the word.
+func isMastered(rec MasteryRecord) bool {
+	return rec.Streak >= 3 && rec.Seen > 0 && float64(rec.Correct)/float64(rec.Seen) >= 0.8
+}
+
+// masteryScore estimates how close rec is to crossing the mastery bar,
+// for ranking "closest to mastery" lists. Unseen words score 0.
+func masteryScore(rec MasteryRecord) float64 {
+	if rec.Seen == 0 {
+		return 0
+	}
+	streakPart := float64(rec.Streak) / 3
+	if streakPart > 1 {
+		streakPart = 1
+	}
+	accuracyPart := float64(rec.Correct) / float64(rec.Seen)
+	return (streakPart + accuracyPart) / 2
+}
+
+// recordMasteryAttempt updates word's lifetime mastery record after an
+// answer, feeding the difficulty-unlock gate in tierUnlocked.
+func (q *Quiz) recordMasteryAttempt(word Word, correct bool) {
+	rec := q.stats.Mastery[word.Word]
+	rec.Seen++
+	rec.LastSeen = time.Now()
+	if correct {
+		rec.Correct++
+		rec.Streak++
+	} else {
+		rec.Streak = 0
+	}
+	q.stats.Mastery[word.Word] = rec
+}
+
+// recordStreak maintains the profile's lifetime answer streak — correct
+// answers in a row across all quizzes — and its all-time high, which
+// the leaderboard reports as the profile's longest streak.
+func (q *Quiz) recordStreak(correct bool) {
+	if correct {
+		q.stats.CurrentStreak++
+		if q.stats.CurrentStreak > q.stats.LongestStreak {
+			q.stats.LongestStreak = q.stats.CurrentStreak
+		}
+	} else {
+		q.stats.CurrentStreak = 0
+	}
+}
+
+// wordsInTier counts how many loaded words fall in tier.
+func (q *Quiz) wordsInTier(tier string) int {
+	n := 0
+	for _, w := range q.words {
+		if wordDifficulty(w) == tier {
+			n++
+		}
 	}
+	return n
+}
+
+// masteredCountInTier counts how many words in tier are mastered.
+func (q *Quiz) masteredCountInTier(tier string) int {
+	n := 0
+	for _, w := range q.words {
+		if wordDifficulty(w) != tier {
+			continue
+		}
+		if isMastered(q.stats.Mastery[w.Word]) {
+			n++
+		}
+	}
+	return n
+}
+
+// easierMasteredCount sums mastered words across every tier strictly
+// before idx in difficultyOrder, for the alternate unlock path.
+func (q *Quiz) easierMasteredCount(idx int) int {
+	n := 0
+	for i := 0; i < idx && i < len(difficultyOrder); i++ {
+		n += q.masteredCountInTier(difficultyOrder[i])
+	}
+	return n
+}
+
+// tierUnlocked reports whether tier is open for play. Tiers other than
+// the first unlock once >=70% of the previous tier's words are
+// mastered, or once masteryUnlockCount words are mastered across all
+// easier tiers combined. When locked, it also returns a message naming
+// the gate.
+func (q *Quiz) tierUnlocked(tier string) (bool, string) {
+	idx := tierIndex(tier)
+	if idx <= 0 {
+		return true, ""
+	}
+	prev := difficultyOrder[idx-1]
+	mastered := q.masteredCountInTier(prev)
+	total := q.wordsInTier(prev)
+	if total == 0 || float64(mastered)/float64(total) >= 0.7 {
+		return true, ""
+	}
+	if q.easierMasteredCount(idx) >= q.masteryUnlockCount {
+		return true, ""
+	}
+	return false, fmt.Sprintf("🔒 %s unlocked at %d/%d %s words mastered (or %d total mastered easier words)",
+		capitalize(tier), mastered, total, prev, q.masteryUnlockCount)
+}
+
+// capitalize upper-cases the first rune of s; strings.Title is
+// deprecated and overkill for our single-word difficulty labels.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// progressBar renders a simple filled/empty bar of the given width.
+func progressBar(done, total, width int) string {
+	if total <= 0 {
+		return "[" + strings.Repeat(" ", width) + "]"
+	}
+	filled := done * width / total
+	if filled > width {
+		filled = width
+	}
+	return "[" + strings.Repeat("█", filled) + strings.Repeat(" ", width-filled) + "]"
+}
+
+// closestToMastery returns up to n not-yet-mastered words from tier,
+// ranked by how close their mastery score is to the threshold.
+func (q *Quiz) closestToMastery(tier string, n int) []Word {
+	var candidates []Word
+	for _, w := range q.words {
+		if wordDifficulty(w) != tier {
+			continue
+		}
+		if isMastered(q.stats.Mastery[w.Word]) {
+			continue
+		}
+		candidates = append(candidates, w)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return masteryScore(q.stats.Mastery[candidates[i].Word]) > masteryScore(q.stats.Mastery[candidates[j].Word])
+	})
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
 }
 
 func (q *Quiz) SaveStats() {
-	dataDir := getDataDir()
-	if err := os.MkdirAll(dataDir, 0o755); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: could not create data dir %s: %v\n", dataDir, err)
+	dir := profilesDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not create profiles dir %s: %v\n", dir, err)
 		return
 	}
 
-	statsFile := filepath.Join(dataDir, "stats.json")
-	file, err := os.Create(statsFile)
+	file, err := os.Create(q.profilePath())
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: could not save stats: %v\n", err)
 		return
@@ -225,8 +953,79 @@ func getDataDir() string {
 	return filepath.Join(homeDir, ".german_quiz")
 }
 
+// profilesDir is where each learner's stats.json-equivalent file lives,
+// one per profile, so a shared machine can host a family/classroom of
+// independent progress tracks plus a leaderboard across all of them.
+func profilesDir() string {
+	return filepath.Join(getDataDir(), "profiles")
+}
+
+// profilePath returns the stats file for q's active profile.
+func (q *Quiz) profilePath() string {
+	return filepath.Join(profilesDir(), q.profileName+".json")
+}
+
+// legacyStatsPath is where the pre-profile build kept its single
+// stats.json, checked as a one-time migration source so an upgrading
+// user's lifetime progress isn't silently orphaned by the move to
+// per-profile files.
+func legacyStatsPath() string {
+	return filepath.Join(getDataDir(), "stats.json")
+}
+
+// sanitizeProfileName strips anything but letters, digits, dashes and
+// underscores so a profile name can never escape the profiles
+// directory, and falls back to "default" if nothing is left.
+func sanitizeProfileName(name string) string {
+	name = strings.TrimSpace(name)
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "default"
+	}
+	return b.String()
+}
+
+// listProfiles returns the profile names with a saved stats file,
+// sorted alphabetically.
+func listProfiles() []string {
+	entries, err := os.ReadDir(profilesDir())
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// chooseProfile resolves the active profile name: the --profile flag
+// wins outright, otherwise it lists existing profiles and prompts for
+// one to load or a new name to create (blank picks/creates "default").
+func chooseProfile(flagValue string, q *Quiz) string {
+	if flagValue != "" {
+		return sanitizeProfileName(flagValue)
+	}
+
+	if names := listProfiles(); len(names) > 0 {
+		fmt.Printf("%sExisting profiles:%s %s\n", ColorCyan, ColorReset, strings.Join(names, ", "))
+	}
+	fmt.Print("Profile name (enter for 'default'): ")
+	return sanitizeProfileName(q.getInput())
+}
+
 func (q *Quiz) ShowWelcome() {
-	fmt.Printf("%s%s=== German Article Quiz ===%s\n\n", ColorBold, ColorBlue, ColorReset)
+	fmt.Printf("%s%s=== German Article Quiz ===%s\n", ColorBold, ColorBlue, ColorReset)
+	fmt.Printf("Profile: %s%s%s\n\n", ColorCyan, q.profileName, ColorReset)
 
 	if q.stats.TotalQuestions > 0 {
 		accuracy := float64(q.stats.CorrectAnswers) / float64(q.stats.TotalQuestions) * 100
@@ -237,6 +1036,16 @@ func (q *Quiz) ShowWelcome() {
 	}
 }
 
+// switchProfile flushes the current profile's stats to disk, then
+// prompts for (or creates) another profile and loads its stats in its
+// place — lets one installation serve a shared classroom/family device.
+func (q *Quiz) switchProfile() {
+	q.SaveStats()
+	q.profileName = chooseProfile("", q)
+	q.LoadStats()
+	fmt.Printf("%sSwitched to profile '%s'.%s\n", ColorGreen, q.profileName, ColorReset)
+}
+
 func (q *Quiz) RunGameLoop() {
 	for {
 		q.ShowMenu()
@@ -244,13 +1053,23 @@ func (q *Quiz) RunGameLoop() {
 
 		switch strings.ToLower(choice) {
 		case "1":
-			q.StartQuiz(10, "")
+			q.StartQuiz(10, "", 0, "articles")
 		case "2":
 			q.ShowCustomMenu()
 		case "3":
 			q.ShowDetailedStats()
 		case "4":
 			q.ShowPracticeMode()
+		case "5":
+			q.StartQuiz(10, "", 0, "meaning")
+		case "6":
+			q.ShowProgress()
+		case "7":
+			q.ShowLeaderboard()
+		case "8":
+			q.StartQuiz(10, "", 0, "plural")
+		case "p":
+			q.switchProfile()
 		case "q", "quit", "exit":
 			fmt.Printf("\n%sTschüss! Keep practicing!%s\n", ColorYellow, ColorReset)
 			return
@@ -266,6 +1085,11 @@ func (q *Quiz) ShowMenu() {
 	fmt.Println("2. Custom Quiz")
 	fmt.Println("3. View Statistics")
 	fmt.Println("4. Practice Mode (focus on mistakes)")
+	fmt.Println("5. Meaning Quiz (10 questions)")
+	fmt.Println("6. Progress")
+	fmt.Println("7. Leaderboard")
+	fmt.Println("8. Plural Quiz (10 questions)")
+	fmt.Printf("p. Switch profile (current: %s%s%s)\n", ColorCyan, q.profileName, ColorReset)
 	fmt.Println("q. Quit")
 	fmt.Print("\nYour choice: ")
 }
@@ -296,11 +1120,53 @@ func (q *Quiz) ShowCustomMenu() {
 		difficulty = "hard"
 	}
 
-	q.StartQuiz(num, difficulty)
+	fmt.Println("\nTime per question:")
+	fmt.Println("1. Off")
+	fmt.Println("2. 10s")
+	fmt.Println("3. 20s")
+	fmt.Println("4. 30s")
+	fmt.Print("Your choice: ")
+
+	var timeLimit time.Duration
+	switch strings.ToLower(q.getInput()) {
+	case "2", "10", "10s":
+		timeLimit = 10 * time.Second
+	case "3", "20", "20s":
+		timeLimit = 20 * time.Second
+	case "4", "30", "30s":
+		timeLimit = 30 * time.Second
+	}
+
+	fmt.Println("\nQuiz mode:")
+	fmt.Println("1. Articles")
+	fmt.Println("2. Meaning")
+	fmt.Println("3. Mixed")
+	fmt.Println("4. Plural")
+	fmt.Print("Your choice: ")
+
+	mode := "articles"
+	switch strings.ToLower(q.getInput()) {
+	case "2", "meaning", "m":
+		mode = "meaning"
+	case "3", "mixed", "x":
+		mode = "mixed"
+	case "4", "plural", "p":
+		mode = "plural"
+	}
+
+	q.StartQuiz(num, difficulty, timeLimit, mode)
 }
 
-func (q *Quiz) StartQuiz(numQuestions int, difficulty string) {
-	// Filter words by difficulty if specified (strict)
+func (q *Quiz) StartQuiz(numQuestions int, difficulty string, timeLimit time.Duration, mode string) {
+	if difficulty != "" {
+		if unlocked, msg := q.tierUnlocked(difficulty); !unlocked {
+			fmt.Printf("%s%s%s\n", ColorYellow, msg, ColorReset)
+			return
+		}
+	}
+
+	// Filter words by difficulty if specified (strict); otherwise drop
+	// words from tiers the learner hasn't unlocked yet.
 	availableWords := q.words
 	if difficulty != "" {
 		filtered := make([]Word, 0, len(q.words))
@@ -314,6 +1180,26 @@ func (q *Quiz) StartQuiz(numQuestions int, difficulty string) {
 		} else {
 			fmt.Printf("%sNo words found for '%s'. Using all levels.%s\n", ColorYellow, difficulty, ColorReset)
 		}
+	} else {
+		unlockedWords := make([]Word, 0, len(q.words))
+		for _, w := range q.words {
+			if unlocked, _ := q.tierUnlocked(wordDifficulty(w)); unlocked {
+				unlockedWords = append(unlockedWords, w)
+			}
+		}
+		if len(unlockedWords) > 0 {
+			availableWords = unlockedWords
+		}
+	}
+
+	if mode == "plural" {
+		withPlural := make([]Word, 0, len(availableWords))
+		for _, w := range availableWords {
+			if w.Plural != "" || w.PluralRegex != "" {
+				withPlural = append(withPlural, w)
+			}
+		}
+		availableWords = withPlural
 	}
 
 	if len(availableWords) == 0 {
@@ -332,18 +1218,14 @@ func (q *Quiz) StartQuiz(numQuestions int, difficulty string) {
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	})
 
-	// Reset session stats
-	q.sessionStats.correct = 0
-	q.sessionStats.total = numQuestions
-	q.sessionStats.mistakes = []MistakeInfo{}
-	q.sessionStats.startTime = time.Now()
+	q.resetSessionStats(numQuestions)
 
 	fmt.Printf("\n%s%sStarting quiz with %d questions...%s\n", ColorBold, ColorCyan, numQuestions, ColorReset)
 	fmt.Println(strings.Repeat("-", 40))
 
 	answered := 0
 	for i := 0; i < numQuestions; i++ {
-		if cont := q.askQuestion(shuffled[i], i+1, numQuestions); !cont {
+		if cont := q.askModeQuestion(shuffled[i], availableWords, i+1, numQuestions, timeLimit, mode); !cont {
 			// Early exit; count only answered so far
 			q.sessionStats.total = answered
 			break
@@ -354,7 +1236,47 @@ func (q *Quiz) StartQuiz(numQuestions int, difficulty string) {
 	q.showResults()
 }
 
-func (q *Quiz) askQuestion(word Word, current, total int) bool {
+// askModeQuestion dispatches a question to the article quiz, the
+// meaning MCQ, or the plural quiz depending on mode ("articles",
+// "meaning", "plural", or "mixed", which picks at random per question
+// among whichever of those word supports).
+func (q *Quiz) askModeQuestion(word Word, pool []Word, current, total int, timeLimit time.Duration, mode string) bool {
+	effective := mode
+	if effective == "mixed" {
+		options := []string{"articles"}
+		if word.English != "" {
+			options = append(options, "meaning")
+		}
+		if word.Plural != "" || word.PluralRegex != "" {
+			options = append(options, "plural")
+		}
+		effective = options[q.rng.Intn(len(options))]
+	}
+	switch effective {
+	case "meaning":
+		return q.askMCQQuestion(word, pool, current, total, timeLimit)
+	case "plural":
+		return q.askPluralQuestion(word, current, total, timeLimit)
+	default:
+		return q.askQuestion(word, current, total, timeLimit)
+	}
+}
+
+// resetSessionStats clears per-quiz bookkeeping before a new run starts.
+func (q *Quiz) resetSessionStats(numQuestions int) {
+	q.sessionStats.correct = 0
+	q.sessionStats.total = numQuestions
+	q.sessionStats.totalPoints = 0
+	q.sessionStats.pointsByDifficulty = make(map[string]int)
+	q.sessionStats.mistakes = []MistakeInfo{}
+	q.sessionStats.answerTimes = nil
+	q.sessionStats.hintsUsed = 0
+	q.sessionStats.questionsNoHints = 0
+	q.sessionStats.correctNoHints = 0
+	q.sessionStats.startTime = time.Now()
+}
+
+func (q *Quiz) askQuestion(word Word, current, total int, timeLimit time.Duration) bool {
 	fmt.Printf("\n%sQuestion %d/%d%s\n", ColorBold, current, total, ColorReset)
 
 	// Show English translation if available
@@ -367,20 +1289,38 @@ func (q *Quiz) askQuestion(word Word, current, total int) bool {
 	fmt.Printf("  %s2.%s der\n", ColorYellow, ColorReset)
 	fmt.Printf("  %s3.%s das\n", ColorYellow, ColorReset)
 	fmt.Printf("\nType 1-3 or 'der/die/das'. '?': hint, 's': skip, 'q': quit quiz\n")
+	if timeLimit > 0 {
+		fmt.Printf("%s⏱ %d seconds per question%s\n", ColorYellow, int(timeLimit.Seconds()), ColorReset)
+	}
+
+	hintIndex, hintCost := 0, 0
 
 	for {
 		fmt.Print("Your answer: ")
-		answer := strings.TrimSpace(strings.ToLower(q.getInput()))
+		answer, elapsed, timedOut := q.readAnswerWithTimeout(timeLimit)
+		q.sessionStats.answerTimes = append(q.sessionStats.answerTimes, elapsed)
+
+		if timedOut {
+			fmt.Printf("\n%s⏰ Time's up!%s\n", ColorRed, ColorReset)
+			q.markWrong(word, "(timeout)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
+			return true
+		}
 
 		switch answer {
 		case "q", "quit", "exit":
 			fmt.Printf("%sExiting quiz early...%s\n", ColorYellow, ColorReset)
 			return false
 		case "?", "h", "hint":
-			printHint(word)
+			q.revealHint(hintsForWord(word), &hintIndex, &hintCost)
 			continue
 		case "s", "skip":
-			q.markWrong(word, "(skip)")
+			q.markWrong(word, "(skip)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
 			return true
 		}
 
@@ -390,8 +1330,15 @@ func (q *Quiz) askQuestion(word Word, current, total int) bool {
 			continue
 		}
 
-		if userArticle == word.Article {
+		correct := userArticle == word.Article
+		if correct {
 			q.sessionStats.correct++
+			points := q.awardPoints(word, elapsed, timeLimit, hintCost)
+			q.sessionStats.totalPoints += points
+			q.sessionStats.pointsByDifficulty[wordDifficulty(word)] += points
+			if hintIndex == 0 {
+				q.sessionStats.correctNoHints++
+			}
 			fmt.Printf("%s✓ Correct!%s", ColorGreen, ColorReset)
 			if word.Plural != "" {
 				fmt.Printf(" (Plural: %s)\n", word.Plural)
@@ -399,34 +1346,269 @@ func (q *Quiz) askQuestion(word Word, current, total int) bool {
 				fmt.Println()
 			}
 		} else {
-			q.markWrong(word, userArticle)
+			q.markWrong(word, userArticle, elapsed)
 		}
+		q.recordHintUsage(hintIndex)
+		q.recordMasteryAttempt(word, correct)
+		q.recordStreak(correct)
 		return true
 	}
 }
 
-func printHint(w Word) {
-	bits := []string{}
-	if w.English != "" {
-		bits = append(bits, "EN: "+w.English)
+// recordHintUsage tallies whether this question was answered without
+// ever revealing a hint, for the "accuracy without hints" figure.
+func (q *Quiz) recordHintUsage(hintIndex int) {
+	if hintIndex == 0 {
+		q.sessionStats.questionsNoHints++
 	}
-	if w.Category != "" {
-		bits = append(bits, "Category: "+w.Category)
+}
+
+// revealHint prints the next unrevealed hint from hints, advancing
+// hintIndex and adding its cost to hintCost, or reports that no more
+// hints are available.
+func (q *Quiz) revealHint(hints []Hint, hintIndex, hintCost *int) {
+	if *hintIndex >= len(hints) {
+		fmt.Printf("%sNo more hints available.%s\n", ColorYellow, ColorReset)
+		return
 	}
-	if w.Difficulty != "" {
-		bits = append(bits, "Difficulty: "+w.Difficulty)
+	h := hints[*hintIndex]
+	*hintIndex++
+	*hintCost += h.Cost
+	q.sessionStats.hintsUsed++
+	fmt.Printf("%sHint %d revealed (-%d pt):%s %s\n", ColorYellow, *hintIndex, h.Cost, ColorReset, h.Text)
+}
+
+// awardPoints returns the points earned for a correct answer: the
+// speed-adjusted base value minus whatever hints cost, never below 0.
+func (q *Quiz) awardPoints(word Word, elapsed, timeLimit time.Duration, hintCost int) int {
+	points := q.speedAdjustedPoints(word, elapsed, timeLimit) - hintCost
+	if points < 0 {
+		points = 0
 	}
-	if w.Plural != "" {
-		bits = append(bits, "Plural: "+w.Plural)
+	return points
+}
+
+// speedAdjustedPoints returns the points earned for a correct answer,
+// scaled down by how much of the time limit was used (no scaling when
+// the question is untimed).
+func (q *Quiz) speedAdjustedPoints(word Word, elapsed, timeLimit time.Duration) int {
+	points := q.pointsForWord(word)
+	if timeLimit <= 0 {
+		return points
 	}
-	if len(bits) == 0 {
-		fmt.Println("No hint available.")
-		return
+	factor := 1 - elapsed.Seconds()/timeLimit.Seconds()
+	if factor < 0 {
+		factor = 0
+	}
+	return int(math.Round(float64(points) * factor))
+}
+
+// readAnswerWithTimeout reads the next answer line, racing it against
+// timeLimit when one is set. While waiting it redraws a "time left"
+// countdown on the prompt line; when colors are disabled it does not
+// redraw, and the callers' static "⏱ N seconds per question" line is the
+// only notice shown.
+func (q *Quiz) readAnswerWithTimeout(timeLimit time.Duration) (answer string, elapsed time.Duration, timedOut bool) {
+	start := time.Now()
+	if timeLimit <= 0 {
+		return strings.TrimSpace(strings.ToLower(q.getInput())), time.Since(start), false
+	}
+
+	deadline := start.Add(timeLimit)
+	liveRedraw := ColorReset != ""
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			q.pendingStaleLine = true
+			return "", timeLimit, true
+		}
+		select {
+		case line := <-q.lines:
+			if q.pendingStaleLine {
+				// A late answer to the question that already timed out
+				// before this one; discard it and keep waiting.
+				q.pendingStaleLine = false
+				continue
+			}
+			if liveRedraw {
+				fmt.Print("\r")
+			}
+			return strings.TrimSpace(strings.ToLower(line.text)), time.Since(start), false
+		case <-ticker.C:
+			if liveRedraw {
+				left := time.Until(deadline).Round(time.Second)
+				if left < 0 {
+					left = 0
+				}
+				fmt.Printf("\r%sYour answer: %s⏱ %ds left%s ", ColorReset, ColorYellow, int(left.Seconds()), ColorReset)
+			}
+		case <-time.After(remaining):
+			q.pendingStaleLine = true
+			return "", timeLimit, true
+		}
+	}
+}
+
+// askMCQQuestion is the meaning-quiz counterpart to askQuestion: the
+// prompt is the German noun with its article, and the learner picks the
+// correct English meaning from a shuffled multiple-choice list.
+func (q *Quiz) askMCQQuestion(word Word, pool []Word, current, total int, timeLimit time.Duration) bool {
+	mcq := buildMCQ(word, pool, 4)
+
+	fmt.Printf("\n%sQuestion %d/%d%s\n", ColorBold, current, total, ColorReset)
+	fmt.Printf("\nWhat does %s%s%s mean?\n", ColorBold, mcq.Prompt, ColorReset)
+	letters := "abcdefgh"
+	for i, choice := range mcq.Choices {
+		fmt.Printf("  %s%c.%s %s\n", ColorYellow, letters[i], ColorReset, choice)
+	}
+	fmt.Printf("\nType a letter or number. '?': hint, 's': skip, 'q': quit quiz\n")
+	if timeLimit > 0 {
+		fmt.Printf("%s⏱ %d seconds per question%s\n", ColorYellow, int(timeLimit.Seconds()), ColorReset)
+	}
+
+	hintIndex, hintCost := 0, 0
+
+	for {
+		fmt.Print("Your answer: ")
+		answer, elapsed, timedOut := q.readAnswerWithTimeout(timeLimit)
+		q.sessionStats.answerTimes = append(q.sessionStats.answerTimes, elapsed)
+
+		if timedOut {
+			fmt.Printf("\n%s⏰ Time's up!%s\n", ColorRed, ColorReset)
+			q.markMeaningWrong(word, "(timeout)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
+			return true
+		}
+
+		switch answer {
+		case "q", "quit", "exit":
+			fmt.Printf("%sExiting quiz early...%s\n", ColorYellow, ColorReset)
+			return false
+		case "?", "h", "hint":
+			q.revealHint(meaningHintsForWord(word), &hintIndex, &hintCost)
+			continue
+		case "s", "skip":
+			q.markMeaningWrong(word, "(skip)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
+			return true
+		}
+
+		idx, ok := parseMCQChoice(answer, len(mcq.Choices))
+		if !ok {
+			fmt.Printf("%sInvalid input. Try a letter or number ('?': hint).%s\n", ColorRed, ColorReset)
+			continue
+		}
+
+		correct := idx == mcq.CorrectIdx
+		if correct {
+			q.sessionStats.correct++
+			points := q.awardPoints(word, elapsed, timeLimit, hintCost)
+			q.sessionStats.totalPoints += points
+			q.sessionStats.pointsByDifficulty[wordDifficulty(word)] += points
+			if hintIndex == 0 {
+				q.sessionStats.correctNoHints++
+			}
+			fmt.Printf("%s✓ Correct!%s\n", ColorGreen, ColorReset)
+		} else {
+			q.markMeaningWrong(word, mcq.Choices[idx], elapsed)
+		}
+		q.recordHintUsage(hintIndex)
+		q.recordMasteryAttempt(word, correct)
+		q.recordStreak(correct)
+		return true
 	}
-	fmt.Printf("Hint: %s\n", strings.Join(bits, " | "))
 }
 
-func (q *Quiz) markWrong(word Word, userArticle string) {
+// askPluralQuestion is the plural-quiz counterpart to askQuestion: the
+// prompt is the article and noun, and the learner types the plural
+// form, matched against Plural, Accept, and PluralRegex.
+func (q *Quiz) askPluralQuestion(word Word, current, total int, timeLimit time.Duration) bool {
+	fmt.Printf("\n%sQuestion %d/%d%s\n", ColorBold, current, total, ColorReset)
+	fmt.Printf("\nPlural of %s%s %s%s?\n", ColorBold, word.Article, word.Word, ColorReset)
+	fmt.Printf("\nType the plural. '?': hint, 's': skip, 'q': quit quiz\n")
+	if timeLimit > 0 {
+		fmt.Printf("%s⏱ %d seconds per question%s\n", ColorYellow, int(timeLimit.Seconds()), ColorReset)
+	}
+
+	hintIndex, hintCost := 0, 0
+
+	for {
+		fmt.Print("Your answer: ")
+		answer, elapsed, timedOut := q.readAnswerWithTimeout(timeLimit)
+		q.sessionStats.answerTimes = append(q.sessionStats.answerTimes, elapsed)
+
+		if timedOut {
+			fmt.Printf("\n%s⏰ Time's up!%s\n", ColorRed, ColorReset)
+			q.markPluralWrong(word, "(timeout)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
+			return true
+		}
+
+		switch answer {
+		case "q", "quit", "exit":
+			fmt.Printf("%sExiting quiz early...%s\n", ColorYellow, ColorReset)
+			return false
+		case "?", "h", "hint":
+			q.revealHint(pluralHintsForWord(word), &hintIndex, &hintCost)
+			continue
+		case "s", "skip":
+			q.markPluralWrong(word, "(skip)", elapsed)
+			q.recordHintUsage(hintIndex)
+			q.recordMasteryAttempt(word, false)
+			q.recordStreak(false)
+			return true
+		}
+
+		correct := matchPlural(word, answer)
+		if correct {
+			q.sessionStats.correct++
+			points := q.awardPoints(word, elapsed, timeLimit, hintCost)
+			q.sessionStats.totalPoints += points
+			q.sessionStats.pointsByDifficulty[wordDifficulty(word)] += points
+			if hintIndex == 0 {
+				q.sessionStats.correctNoHints++
+			}
+			fmt.Printf("%s✓ Correct!%s\n", ColorGreen, ColorReset)
+		} else {
+			q.markPluralWrong(word, answer, elapsed)
+		}
+		q.recordHintUsage(hintIndex)
+		q.recordMasteryAttempt(word, correct)
+		q.recordStreak(correct)
+		return true
+	}
+}
+
+// parseMCQChoice accepts either a 1-based number or a letter (a, b, c...)
+// and returns the matching 0-based choice index.
+func parseMCQChoice(in string, numChoices int) (int, bool) {
+	in = strings.TrimSpace(strings.ToLower(in))
+	if in == "" {
+		return 0, false
+	}
+	if n, err := strconv.Atoi(in); err == nil {
+		if n >= 1 && n <= numChoices {
+			return n - 1, true
+		}
+		return 0, false
+	}
+	if len(in) == 1 && in[0] >= 'a' && in[0] < 'a'+byte(numChoices) {
+		return int(in[0] - 'a'), true
+	}
+	return 0, false
+}
+
+func (q *Quiz) markWrong(word Word, userArticle string, elapsed time.Duration) {
 	fmt.Printf("%s✗ Wrong!%s The correct answer is %s%s%s %s\n",
 		ColorRed, ColorReset, ColorGreen, word.Article, ColorReset, word.Word)
 
@@ -434,10 +1616,52 @@ func (q *Quiz) markWrong(word Word, userArticle string) {
 		word:          word,
 		userAnswer:    userArticle,
 		correctAnswer: word.Article,
+		elapsed:       elapsed,
 	})
 
 	// Track mistakes for practice mode
-	q.stats.WordStats[word.Word]++
+	ws := q.stats.WordStats[word.Word]
+	ws.ArticleMisses++
+	q.stats.WordStats[word.Word] = ws
+}
+
+// markMeaningWrong is markWrong's counterpart for the meaning-MCQ mode:
+// it reports the correct English meaning instead of the article, and
+// tallies the mistake under MeaningMisses so practice mode can weight
+// the two modes independently.
+func (q *Quiz) markMeaningWrong(word Word, userAnswer string, elapsed time.Duration) {
+	fmt.Printf("%s✗ Wrong!%s The correct meaning is %s%s%s\n",
+		ColorRed, ColorReset, ColorGreen, word.English, ColorReset)
+
+	q.sessionStats.mistakes = append(q.sessionStats.mistakes, MistakeInfo{
+		word:          word,
+		userAnswer:    userAnswer,
+		correctAnswer: word.English,
+		elapsed:       elapsed,
+	})
+
+	ws := q.stats.WordStats[word.Word]
+	ws.MeaningMisses++
+	q.stats.WordStats[word.Word] = ws
+}
+
+// markPluralWrong is markWrong's counterpart for the plural-quiz mode:
+// it reports the correct plural instead of the article, and tallies the
+// mistake under PluralMisses.
+func (q *Quiz) markPluralWrong(word Word, userAnswer string, elapsed time.Duration) {
+	fmt.Printf("%s✗ Wrong!%s The plural is %s%s%s\n",
+		ColorRed, ColorReset, ColorGreen, pluralDisplay(word), ColorReset)
+
+	q.sessionStats.mistakes = append(q.sessionStats.mistakes, MistakeInfo{
+		word:          word,
+		userAnswer:    userAnswer,
+		correctAnswer: pluralDisplay(word),
+		elapsed:       elapsed,
+	})
+
+	ws := q.stats.WordStats[word.Word]
+	ws.PluralMisses++
+	q.stats.WordStats[word.Word] = ws
 }
 
 func (q *Quiz) parseArticle(in string) (string, bool) {
@@ -479,13 +1703,32 @@ func (q *Quiz) showResults() {
 	fmt.Printf("\n%s", strings.Repeat("=", 40))
 	fmt.Printf("\n%sQuiz Complete!%s\n", ColorBold, ColorReset)
 	fmt.Printf("Time: %v\n", duration)
-	fmt.Printf("Score: %s%d/%d (%.1f%%)%s\n",
-		getColorForScore(percentage), q.sessionStats.correct, q.sessionStats.total, percentage, ColorReset)
+	fmt.Printf("Score: %s%d points (%d/%d correct)%s\n",
+		getColorForScore(percentage), q.sessionStats.totalPoints, q.sessionStats.correct, q.sessionStats.total, ColorReset)
+
+	if len(q.sessionStats.answerTimes) > 0 {
+		var total time.Duration
+		for _, t := range q.sessionStats.answerTimes {
+			total += t
+		}
+		avg := total / time.Duration(len(q.sessionStats.answerTimes))
+		fmt.Printf("Average answer time: %v\n", avg.Round(time.Millisecond*10))
+	}
 
 	// Update global stats
 	q.stats.TotalQuizzes++
 	q.stats.TotalQuestions += q.sessionStats.total
 	q.stats.CorrectAnswers += q.sessionStats.correct
+	q.stats.TotalPoints += q.sessionStats.totalPoints
+	for level, points := range q.sessionStats.pointsByDifficulty {
+		q.stats.PointsByDifficulty[level] += points
+	}
+	q.stats.HintsUsed += q.sessionStats.hintsUsed
+	q.stats.QuestionsNoHints += q.sessionStats.questionsNoHints
+	q.stats.CorrectNoHints += q.sessionStats.correctNoHints
+	if percentage > q.stats.BestAccuracy {
+		q.stats.BestAccuracy = percentage
+	}
 
 	// Show mistakes if any
 	if len(q.sessionStats.mistakes) > 0 {
@@ -526,7 +1769,19 @@ func (q *Quiz) ShowDetailedStats() {
 	fmt.Printf("Total Quizzes: %d\n", q.stats.TotalQuizzes)
 	fmt.Printf("Total Questions: %d\n", q.stats.TotalQuestions)
 	fmt.Printf("Correct Answers: %d\n", q.stats.CorrectAnswers)
+	fmt.Printf("Total Points: %d\n", q.stats.TotalPoints)
+	for _, tier := range difficultyOrder {
+		if pts := q.stats.PointsByDifficulty[tier]; pts > 0 {
+			fmt.Printf("  %-8s %d\n", capitalize(tier)+":", pts)
+		}
+	}
 	fmt.Printf("Overall Accuracy: %s%.1f%%%s\n", getColorForScore(accuracy), accuracy, ColorReset)
+	fmt.Printf("Hints Used: %d\n", q.stats.HintsUsed)
+	if q.stats.QuestionsNoHints > 0 {
+		noHintAccuracy := float64(q.stats.CorrectNoHints) / float64(q.stats.QuestionsNoHints) * 100
+		fmt.Printf("Accuracy without hints: %s%.1f%%%s (%d/%d)\n",
+			getColorForScore(noHintAccuracy), noHintAccuracy, ColorReset, q.stats.CorrectNoHints, q.stats.QuestionsNoHints)
+	}
 
 	// Show most missed words
 	if len(q.stats.WordStats) > 0 {
@@ -536,8 +1791,8 @@ func (q *Quiz) ShowDetailedStats() {
 			errors int
 		}
 		sorted := make([]wordError, 0, len(q.stats.WordStats))
-		for w, c := range q.stats.WordStats {
-			if c > 0 {
+		for w, ws := range q.stats.WordStats {
+			if c := ws.ArticleMisses + ws.MeaningMisses + ws.PluralMisses; c > 0 {
 				sorted = append(sorted, wordError{w, c})
 			}
 		}
@@ -565,11 +1820,90 @@ func (q *Quiz) ShowDetailedStats() {
 	}
 }
 
+// ShowProgress renders a mastery progress bar for each difficulty tier
+// that has words, plus the lock status of the next tier and the words
+// closest to pushing it over the unlock threshold.
+func (q *Quiz) ShowProgress() {
+	fmt.Printf("\n%s%sMastery Progress:%s\n", ColorBold, ColorCyan, ColorReset)
+	fmt.Println(strings.Repeat("-", 40))
+
+	for i, tier := range difficultyOrder {
+		total := q.wordsInTier(tier)
+		if total == 0 {
+			continue
+		}
+		mastered := q.masteredCountInTier(tier)
+		fmt.Printf("%-8s %s %d/%d mastered\n", capitalize(tier), progressBar(mastered, total, 20), mastered, total)
+
+		if i+1 >= len(difficultyOrder) || q.wordsInTier(difficultyOrder[i+1]) == 0 {
+			continue
+		}
+		if unlocked, msg := q.tierUnlocked(difficultyOrder[i+1]); !unlocked {
+			fmt.Printf("  %s%s%s\n", ColorYellow, msg, ColorReset)
+			if closest := q.closestToMastery(tier, 5); len(closest) > 0 {
+				fmt.Println("  Closest to mastery:")
+				for _, w := range closest {
+					fmt.Printf("    • %s %s\n", w.Article, w.Word)
+				}
+			}
+		}
+	}
+}
+
+// ShowLeaderboard scans every saved profile, aggregates total points,
+// best single-quiz accuracy, and longest answer streak, and prints a
+// table ranked by total points with medal emojis for the top 3.
+func (q *Quiz) ShowLeaderboard() {
+	names := listProfiles()
+	if len(names) == 0 {
+		fmt.Printf("\n%sNo saved profiles yet.%s\n", ColorYellow, ColorReset)
+		return
+	}
+
+	type ranked struct {
+		name          string
+		totalPoints   int
+		bestAccuracy  float64
+		longestStreak int
+	}
+	var ranklist []ranked
+	for _, name := range names {
+		stats, err := loadProfileStats(filepath.Join(profilesDir(), name+".json"))
+		if err != nil {
+			continue
+		}
+		ranklist = append(ranklist, ranked{name, stats.TotalPoints, stats.BestAccuracy, stats.LongestStreak})
+	}
+	sort.Slice(ranklist, func(i, j int) bool { return ranklist[i].totalPoints > ranklist[j].totalPoints })
+
+	medals := []string{"🥇", "🥈", "🥉"}
+
+	fmt.Printf("\n%s%sLeaderboard:%s\n", ColorBold, ColorCyan, ColorReset)
+	fmt.Println(strings.Repeat("-", 50))
+	fmt.Printf("%-4s %-14s %10s %12s %8s\n", "", "Profile", "Points", "Best Acc.", "Streak")
+	for i, r := range ranklist {
+		rank := fmt.Sprintf("%d.", i+1)
+		if i < len(medals) {
+			rank = medals[i]
+		}
+		fmt.Printf("%-4s %-14s %10d %11.1f%% %8d\n", rank, r.name, r.totalPoints, r.bestAccuracy, r.longestStreak)
+	}
+	fmt.Println(strings.Repeat("-", 50))
+}
+
+// practiceItem pairs a challenging word with the specific mode it was
+// actually missed in, so practice mode drills it the way it was missed.
+type practiceItem struct {
+	word Word
+	mode string
+}
+
 func (q *Quiz) ShowPracticeMode() {
-	// Collect unique challenging words
+	// Collect unique challenging words: any word missed in any mode.
 	challenging := make([]Word, 0, len(q.words))
 	for _, word := range q.words {
-		if q.stats.WordStats[word.Word] > 0 {
+		ws := q.stats.WordStats[word.Word]
+		if ws.ArticleMisses > 0 || ws.MeaningMisses > 0 || ws.PluralMisses > 0 {
 			challenging = append(challenging, word)
 		}
 	}
@@ -579,16 +1913,33 @@ func (q *Quiz) ShowPracticeMode() {
 		return
 	}
 
-	// Weight by mistakes (cap repeats to 3)
-	practiceWords := make([]Word, 0, len(challenging)*2)
+	// Weight by mistakes times the word's point value, so repeated
+	// failures on high-value words dominate the practice pool (cap
+	// repeats to keep any single word/mode from crowding out the
+	// rest). Each mode's misses are weighted independently, so a word
+	// missed only in the meaning quiz gets drilled there, not in the
+	// article quiz it's never actually failed.
+	practiceItems := make([]practiceItem, 0, len(challenging)*2)
 	for _, word := range challenging {
-		mistakes := q.stats.WordStats[word.Word]
-		repeats := mistakes + 1
-		if repeats > 3 {
-			repeats = 3
-		}
-		for i := 0; i < repeats; i++ {
-			practiceWords = append(practiceWords, word)
+		ws := q.stats.WordStats[word.Word]
+		for _, m := range []struct {
+			mode   string
+			misses int
+		}{
+			{"articles", ws.ArticleMisses},
+			{"meaning", ws.MeaningMisses},
+			{"plural", ws.PluralMisses},
+		} {
+			if m.misses == 0 {
+				continue
+			}
+			repeats := (m.misses + 1) * q.pointsForWord(word)
+			if repeats > 9 {
+				repeats = 9
+			}
+			for i := 0; i < repeats; i++ {
+				practiceItems = append(practiceItems, practiceItem{word: word, mode: m.mode})
+			}
 		}
 	}
 
@@ -596,24 +1947,21 @@ func (q *Quiz) ShowPracticeMode() {
 		ColorYellow, len(challenging), ColorReset)
 
 	numQuestions := 10
-	if numQuestions > len(practiceWords) {
-		numQuestions = len(practiceWords)
+	if numQuestions > len(practiceItems) {
+		numQuestions = len(practiceItems)
 	}
 
 	// Shuffle and start practice quiz
-	q.rng.Shuffle(len(practiceWords), func(i, j int) {
-		practiceWords[i], practiceWords[j] = practiceWords[j], practiceWords[i]
+	q.rng.Shuffle(len(practiceItems), func(i, j int) {
+		practiceItems[i], practiceItems[j] = practiceItems[j], practiceItems[i]
 	})
 
-	// Reset session stats
-	q.sessionStats.correct = 0
-	q.sessionStats.total = numQuestions
-	q.sessionStats.mistakes = []MistakeInfo{}
-	q.sessionStats.startTime = time.Now()
+	q.resetSessionStats(numQuestions)
 
 	answered := 0
 	for i := 0; i < numQuestions; i++ {
-		if cont := q.askQuestion(practiceWords[i], i+1, numQuestions); !cont {
+		item := practiceItems[i]
+		if cont := q.askModeQuestion(item.word, q.words, i+1, numQuestions, 0, item.mode); !cont {
 			q.sessionStats.total = answered
 			break
 		}
@@ -623,11 +1971,23 @@ func (q *Quiz) ShowPracticeMode() {
 	q.showResults()
 }
 
+// nextLine returns the next real line of input. If the previous
+// question timed out, the player's late answer to it may still be in
+// flight on q.lines; that one line is discarded first so it never gets
+// attributed to whatever prompt asks for input next.
+func (q *Quiz) nextLine() inputLine {
+	if q.pendingStaleLine {
+		q.pendingStaleLine = false
+		<-q.lines
+	}
+	return <-q.lines
+}
+
 func (q *Quiz) getInput() string {
-	text, err := q.reader.ReadString('\n')
-	if err != nil {
+	line := q.nextLine()
+	if line.err != nil {
 		// If we captured some text before error, return it; otherwise exit gracefully
-		t := strings.TrimSpace(text)
+		t := strings.TrimSpace(line.text)
 		if t != "" {
 			return t
 		}
@@ -636,5 +1996,5 @@ func (q *Quiz) getInput() string {
 		q.SaveStats()
 		os.Exit(0)
 	}
-	return strings.TrimSpace(text)
+	return strings.TrimSpace(line.text)
 }
\ No newline at end of file